@@ -0,0 +1,41 @@
+package webtester
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+)
+
+func (b *Browser) dumpArtifacts(dir string) {
+	name := sanitizeArtifactName(b.Name())
+
+	if buf, err := b.session.Screenshot(); err != nil {
+		b.Log(err)
+	} else if err := ioutil.WriteFile(filepath.Join(dir, name+".png"), buf, 0644); err != nil {
+		b.Log(err)
+	}
+
+	if source, err := b.session.Source(); err != nil {
+		b.Log(err)
+	} else if err := ioutil.WriteFile(filepath.Join(dir, name+".html"), []byte(source), 0644); err != nil {
+		b.Log(err)
+	}
+
+	if entries, err := b.fetchLogs("browser"); err != nil {
+		b.Log(err)
+	} else {
+		var lines strings.Builder
+		for _, entry := range entries {
+			fmt.Fprintf(&lines, "%+v\n", entry)
+		}
+		if err := ioutil.WriteFile(filepath.Join(dir, name+".log"), []byte(lines.String()), 0644); err != nil {
+			b.Log(err)
+		}
+	}
+}
+
+func sanitizeArtifactName(name string) string {
+	replacer := strings.NewReplacer("/", "_", " ", "_")
+	return replacer.Replace(name)
+}
@@ -0,0 +1,51 @@
+package webtester
+
+import "github.com/bborbe/webdriver"
+
+func (b *Browser) SetCookie(cookie webdriver.Cookie) *Browser {
+	b.Helper()
+	if err := b.session.SetCookie(cookie); err != nil {
+		b.Fatal(err)
+	}
+	return b
+}
+
+func (b *Browser) SetCookies(cookies ...webdriver.Cookie) *Browser {
+	b.Helper()
+	for _, cookie := range cookies {
+		if err := b.session.SetCookie(cookie); err != nil {
+			b.Fatal(err)
+		}
+	}
+	return b
+}
+
+func (b *Browser) GetCookies() []webdriver.Cookie {
+	b.Helper()
+	cookies, err := b.session.GetCookies()
+	if err != nil {
+		b.Fatal(err)
+	}
+	return cookies
+}
+
+func (b *Browser) DeleteCookie(name string) *Browser {
+	b.Helper()
+	if err := b.session.DeleteCookieByName(name); err != nil {
+		b.Fatal(err)
+	}
+	return b
+}
+
+func (b *Browser) DeleteAllCookies() *Browser {
+	b.Helper()
+	if err := b.session.DeleteCookies(); err != nil {
+		b.Fatal(err)
+	}
+	return b
+}
+
+func (b *Browser) ClearSession() *Browser {
+	b.Helper()
+	return b.DeleteAllCookies()
+}
@@ -0,0 +1,109 @@
+package webtester
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/bborbe/webdriver"
+)
+
+func (e *Element) Fill(text string) *Element {
+	e.Helper()
+	if err := e.elem.Clear(); err != nil {
+		e.Fatal(err)
+	}
+	if err := e.elem.SendKeys(text); err != nil {
+		e.Fatal(err)
+	}
+	return e
+}
+
+func (e *Element) Click() *Element {
+	e.Helper()
+	if err := e.elem.Click(); err != nil {
+		e.Fatal(err)
+	}
+	return e
+}
+
+func (e *Element) Submit() *Element {
+	e.Helper()
+	if err := e.elem.Submit(); err != nil {
+		e.Fatal(err)
+	}
+	return e
+}
+
+func (e *Element) SendKeys(keys string) *Element {
+	e.Helper()
+	if err := e.elem.SendKeys(keys); err != nil {
+		e.Fatal(err)
+	}
+	return e
+}
+
+func (e *Element) Clear() *Element {
+	e.Helper()
+	if err := e.elem.Clear(); err != nil {
+		e.Fatal(err)
+	}
+	return e
+}
+
+func (e *Element) Select(optionValue string) *Element {
+	e.Helper()
+	escaped := strings.ReplaceAll(optionValue, `"`, `\"`)
+	option, err := e.elem.FindElement(webdriver.CSS_Selector, fmt.Sprintf(`option[value="%s"]`, escaped))
+	if err != nil {
+		e.Fatal(err)
+	}
+	if err := option.Click(); err != nil {
+		e.Fatal(err)
+	}
+	return e
+}
+
+func (e *Element) Attribute(name string) string {
+	e.Helper()
+	value, err := e.elem.GetAttribute(name)
+	if err != nil {
+		e.Fatal(err)
+	}
+	return value
+}
+
+func (b *Browser) Fill(target string, text string) *Browser {
+	b.Helper()
+	b.MustFindElement(target).Fill(text)
+	return b
+}
+
+func (b *Browser) Click(target string) *Browser {
+	b.Helper()
+	b.MustFindElement(target).Click()
+	return b
+}
+
+func (b *Browser) Submit(target string) *Browser {
+	b.Helper()
+	b.MustFindElement(target).Submit()
+	return b
+}
+
+func (b *Browser) SendKeys(target string, keys string) *Browser {
+	b.Helper()
+	b.MustFindElement(target).SendKeys(keys)
+	return b
+}
+
+func (b *Browser) Clear(target string) *Browser {
+	b.Helper()
+	b.MustFindElement(target).Clear()
+	return b
+}
+
+func (b *Browser) Select(target string, optionValue string) *Browser {
+	b.Helper()
+	b.MustFindElement(target).Select(optionValue)
+	return b
+}
@@ -0,0 +1,123 @@
+package webtester
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/bborbe/webdriver"
+)
+
+type config struct {
+	chromeArgs   []string
+	windowWidth  int
+	windowHeight int
+	userAgent    string
+	proxy        string
+	downloadDir  string
+	artifactDir  string
+	loggingPrefs map[string]string
+}
+
+type Option func(*config)
+
+func WithHeadless() Option {
+	return func(c *config) {
+		c.chromeArgs = append(c.chromeArgs, "--headless", "--no-sandbox", "--disable-gpu")
+	}
+}
+
+func WithWindowSize(w, h int) Option {
+	return func(c *config) {
+		c.windowWidth = w
+		c.windowHeight = h
+	}
+}
+
+func WithUserAgent(userAgent string) Option {
+	return func(c *config) {
+		c.userAgent = userAgent
+	}
+}
+
+func WithChromeArgs(args ...string) Option {
+	return func(c *config) {
+		c.chromeArgs = append(c.chromeArgs, args...)
+	}
+}
+
+func WithProxy(url string) Option {
+	return func(c *config) {
+		c.proxy = url
+	}
+}
+
+func WithDownloadDir(path string) Option {
+	return func(c *config) {
+		c.downloadDir = path
+	}
+}
+
+func WithArtifactDir(dir string) Option {
+	return func(c *config) {
+		c.artifactDir = dir
+	}
+}
+
+func WithLoggingPrefs(prefs map[string]string) Option {
+	return func(c *config) {
+		c.loggingPrefs = prefs
+	}
+}
+
+func (c *config) capabilities() webdriver.Capabilities {
+	args := append([]string{}, c.chromeArgs...)
+	if c.windowWidth > 0 && c.windowHeight > 0 {
+		args = append(args, fmt.Sprintf("--window-size=%d,%d", c.windowWidth, c.windowHeight))
+	}
+	if c.userAgent != "" {
+		args = append(args, fmt.Sprintf("--user-agent=%s", c.userAgent))
+	}
+	if c.proxy != "" {
+		args = append(args, fmt.Sprintf("--proxy-server=%s", c.proxy))
+	}
+
+	chromeOptions := map[string]interface{}{
+		"args": args,
+	}
+	if c.downloadDir != "" {
+		chromeOptions["prefs"] = map[string]interface{}{
+			"download.default_directory": c.downloadDir,
+		}
+	}
+
+	caps := webdriver.Capabilities{
+		"Platform":           "Linux",
+		"goog:chromeOptions": chromeOptions,
+	}
+	if len(c.loggingPrefs) > 0 {
+		caps["goog:loggingPrefs"] = c.loggingPrefs
+	}
+	return caps
+}
+
+func SetupWithOptions(tb testing.TB, path string, opts ...Option) *Driver {
+	tb.Helper()
+
+	webDriver := webdriver.NewChromeDriver(path)
+	err := webDriver.Start()
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	c := &config{}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	return &Driver{
+		TB:           tb,
+		webDriver:    webDriver,
+		capabilities: c.capabilities(),
+		artifactDir:  c.artifactDir,
+	}
+}
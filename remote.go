@@ -0,0 +1,33 @@
+package webtester
+
+import (
+	"testing"
+
+	"github.com/bborbe/webdriver"
+)
+
+func SetupFirefox(tb testing.TB, firefoxPath, xpiPath string) *Driver {
+	tb.Helper()
+
+	webDriver := webdriver.NewFirefoxDriver(firefoxPath, xpiPath)
+	err := webDriver.Start()
+	if err != nil {
+		tb.Fatal(err)
+	}
+
+	return &Driver{
+		TB:           tb,
+		webDriver:    webDriver,
+		capabilities: webdriver.Capabilities{"Platform": "Linux"},
+	}
+}
+
+func SetupWithDriver(tb testing.TB, webDriver webdriver.WebDriver) *Driver {
+	tb.Helper()
+
+	return &Driver{
+		TB:           tb,
+		webDriver:    webDriver,
+		capabilities: webdriver.Capabilities{"Platform": "Linux"},
+	}
+}
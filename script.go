@@ -0,0 +1,70 @@
+package webtester
+
+import "encoding/json"
+
+type LogEntry struct {
+	Level     string
+	Message   string
+	Timestamp int64
+}
+
+func (b *Browser) ExecuteScript(script string, args ...interface{}) interface{} {
+	b.Helper()
+	data, err := b.session.ExecuteScript(script, args)
+	if err != nil {
+		b.Fatal(err)
+	}
+	var result interface{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		b.Fatal(err)
+	}
+	return result
+}
+
+func (b *Browser) ExecuteAsyncScript(script string, args ...interface{}) interface{} {
+	b.Helper()
+	data, err := b.session.ExecuteScriptAsync(script, args)
+	if err != nil {
+		b.Fatal(err)
+	}
+	var result interface{}
+	if err := json.Unmarshal(data, &result); err != nil {
+		b.Fatal(err)
+	}
+	return result
+}
+
+func (b *Browser) ConsoleLogs() []LogEntry {
+	b.Helper()
+	entries, err := b.fetchLogs("browser")
+	if err != nil {
+		b.Fatal(err)
+	}
+	return entries
+}
+
+func (b *Browser) NetworkLogs() []LogEntry {
+	b.Helper()
+	entries, err := b.fetchLogs("performance")
+	if err != nil {
+		b.Fatal(err)
+	}
+	return entries
+}
+
+func (b *Browser) fetchLogs(logType string) ([]LogEntry, error) {
+	raw, err := b.session.Log(logType)
+	if err != nil {
+		return nil, err
+	}
+
+	entries := make([]LogEntry, 0, len(raw))
+	for _, r := range raw {
+		entries = append(entries, LogEntry{
+			Level:     r.Level,
+			Message:   r.Message,
+			Timestamp: int64(r.TimeStamp),
+		})
+	}
+	return entries, nil
+}
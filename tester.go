@@ -14,8 +14,11 @@ import (
 
 type Driver struct {
 	testing.TB
-	webDriver webdriver.WebDriver
-	sessions  []*webdriver.Session
+	webDriver    webdriver.WebDriver
+	sessions     []*webdriver.Session
+	browsers     []*Browser
+	capabilities webdriver.Capabilities
+	artifactDir  string
 }
 
 func Setup(tb testing.TB, path string) *Driver {
@@ -28,12 +31,18 @@ func Setup(tb testing.TB, path string) *Driver {
 	}
 
 	return &Driver{
-		TB:        tb,
-		webDriver: webDriver,
+		TB:           tb,
+		webDriver:    webDriver,
+		capabilities: webdriver.Capabilities{"Platform": "Linux"},
 	}
 }
 
 func (d *Driver) TearDown() {
+	if d.artifactDir != "" && d.Failed() {
+		for _, browser := range d.browsers {
+			browser.dumpArtifacts(d.artifactDir)
+		}
+	}
 	for _, session := range d.sessions {
 		session.Delete()
 	}
@@ -49,7 +58,10 @@ type Browser struct {
 func (d *Driver) OpenBrowser() *Browser {
 	d.Helper()
 
-	desired := webdriver.Capabilities{"Platform": "Linux"}
+	desired := d.capabilities
+	if desired == nil {
+		desired = webdriver.Capabilities{"Platform": "Linux"}
+	}
 	required := webdriver.Capabilities{}
 	session, err := d.webDriver.NewSession(desired, required)
 	if err != nil {
@@ -58,10 +70,13 @@ func (d *Driver) OpenBrowser() *Browser {
 
 	d.sessions = append(d.sessions, session)
 
-	return &Browser{
+	browser := &Browser{
 		TB:      d.TB,
 		session: session,
 	}
+	d.browsers = append(d.browsers, browser)
+
+	return browser
 }
 
 func (b *Browser) Session() (session *webdriver.Session) {
@@ -96,15 +111,11 @@ func (b *Browser) VisitTo(rawurl string) *Browser {
 
 func (b *Browser) WaitFor(target string) *Browser {
 	b.Helper()
-	using, value := splitTarget(b.TB, target)
+	b.Wait().Until(ElementVisible(target))
 
-	var elem webdriver.WebElement
-	var err error
-	ok := wait(func() bool {
-		elem, err = b.session.FindElement(using, value)
-		return err == nil
-	})
-	if !ok {
+	using, value := splitTarget(b.TB, target)
+	elem, err := b.session.FindElement(using, value)
+	if err != nil {
 		b.Fatal(err)
 	}
 	b.element = elem
@@ -145,10 +156,8 @@ func (b *Browser) Expect(target string, text string) {
 	b.Helper()
 	using, value := splitTarget(b.TB, target)
 
-	var elems []webdriver.WebElement
-	var err error
-	ok := wait(func() bool {
-		elems, err = b.session.FindElements(using, value)
+	ok := b.Wait().Poll(func(b *Browser) bool {
+		elems, err := b.session.FindElements(using, value)
 		if err != nil {
 			return false
 		}
@@ -164,7 +173,6 @@ func (b *Browser) Expect(target string, text string) {
 		return false
 	})
 	if !ok {
-		b.Log(err)
 		b.Fatalf("not found: %s", text)
 	}
 }
@@ -250,19 +258,21 @@ func (b *Browser) ExpectTransitTo(rawurl string) *Browser {
 	if err != nil {
 		b.Fatal(err)
 	}
-	ok := wait(func() bool {
+
+	ok := b.Wait().Poll(func(b *Browser) bool {
 		ru, err := b.session.GetUrl()
 		if err != nil {
 			b.Log(err)
+			return false
 		}
 		u, err := url.Parse(ru)
 		if err != nil {
 			b.Log(err)
+			return false
 		}
 		return u.Path == expect.Path
 	})
 	if !ok {
-		b.Log(err)
 		b.Fatalf("not found: %s", rawurl)
 	}
 	return b
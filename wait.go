@@ -0,0 +1,133 @@
+package webtester
+
+import (
+	"encoding/json"
+	"regexp"
+	"time"
+)
+
+const (
+	defaultWaitTimeout  = 10 * time.Second
+	defaultWaitInterval = 100 * time.Millisecond
+)
+
+type Waiter struct {
+	browser  *Browser
+	timeout  time.Duration
+	interval time.Duration
+}
+
+func (b *Browser) Wait() *Waiter {
+	return &Waiter{
+		browser:  b,
+		timeout:  defaultWaitTimeout,
+		interval: defaultWaitInterval,
+	}
+}
+
+func (w *Waiter) WithTimeout(timeout time.Duration) *Waiter {
+	w.timeout = timeout
+	return w
+}
+
+func (w *Waiter) WithInterval(interval time.Duration) *Waiter {
+	w.interval = interval
+	return w
+}
+
+func (w *Waiter) Poll(cond func(*Browser) bool) bool {
+	w.browser.Helper()
+
+	deadline := time.Now().Add(w.timeout)
+	for {
+		if cond(w.browser) {
+			return true
+		}
+		if time.Now().After(deadline) {
+			return false
+		}
+		time.Sleep(w.interval)
+	}
+}
+
+func (w *Waiter) Until(cond func(*Browser) bool) *Browser {
+	w.browser.Helper()
+	if !w.Poll(cond) {
+		w.browser.Fatalf("condition not met within %s", w.timeout)
+	}
+	return w.browser
+}
+
+func ElementVisible(target string) func(*Browser) bool {
+	return func(b *Browser) bool {
+		using, value := splitTarget(b.TB, target)
+		_, err := b.session.FindElement(using, value)
+		return err == nil
+	}
+}
+
+func ElementCount(target string, n int) func(*Browser) bool {
+	return func(b *Browser) bool {
+		using, value := splitTarget(b.TB, target)
+		elems, err := b.session.FindElements(using, value)
+		if err != nil {
+			return false
+		}
+		return len(elems) == n
+	}
+}
+
+func TextMatches(target string, re *regexp.Regexp) func(*Browser) bool {
+	return func(b *Browser) bool {
+		using, value := splitTarget(b.TB, target)
+		elems, err := b.session.FindElements(using, value)
+		if err != nil {
+			return false
+		}
+		for _, elem := range elems {
+			text, err := elem.Text()
+			if err != nil {
+				continue
+			}
+			if re.MatchString(text) {
+				return true
+			}
+		}
+		return false
+	}
+}
+
+func URLMatches(re *regexp.Regexp) func(*Browser) bool {
+	return func(b *Browser) bool {
+		u, err := b.session.GetUrl()
+		if err != nil {
+			return false
+		}
+		return re.MatchString(u)
+	}
+}
+
+func TitleIs(title string) func(*Browser) bool {
+	return func(b *Browser) bool {
+		actual, err := b.session.Title()
+		if err != nil {
+			return false
+		}
+		return actual == title
+	}
+}
+
+func JSReturnsTrue(script string) func(*Browser) bool {
+	return func(b *Browser) bool {
+		data, err := b.session.ExecuteScript(script, nil)
+		if err != nil {
+			return false
+		}
+		var result interface{}
+		if err := json.Unmarshal(data, &result); err != nil {
+			return false
+		}
+		ok, _ := result.(bool)
+		return ok
+	}
+}